@@ -0,0 +1,314 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/magifd2/sdate/pkg/sdate"
+)
+
+// version is set at build time
+var version = "dev"
+
+// helpMessage returns the detailed help string.
+func helpMessage() string {
+	return "Usage: sdate [--op <operation>] [--base <time>] [--format <layout>] [--output-tz <timezone>]\n" +
+		"       sdate [--earliest <operation>] [--latest <operation>] [--output <mode>] ...\n\n" +
+		"This tool generates a timestamp based on a Splunk-like relative time and snap operation.\n\n" +
+		"Options:\n" +
+		"\t--op <operation>\n" +
+		"\t\tA string specifying the operation to perform: any number of relative\n" +
+		"\t\tand snap tokens, applied left-to-right. This argument is optional.\n" +
+		"\t\tIf not specified (or 'now'), the current time is used without any operation.\n" +
+		"\t\tExamples:\n" +
+		"\t\t- '-1d@d': 1 day ago, snapped to the beginning of the day.\n" +
+		"\t\t- '@h': snapped to the beginning of the hour.\n" +
+		"\t\t- '+2h': 2 hours from now.\n" +
+		"\t\t- '-1d@d+8h': 1 day ago, snapped to the start of the day, plus 8 hours.\n" +
+		"\t\t- '@q': snapped to the beginning of the current quarter.\n" +
+		"\t\t- '@w1' or '@mon': snapped to the most recent Monday.\n" +
+		"\t\t- '-mon': the previous Monday.\n\n" +
+		"Supported Units:\n" +
+		"\ts: seconds\n" +
+		"\tm: minutes\n" +
+		"\th: hours\n" +
+		"\td: days\n" +
+		"\tw: weeks (start of week set by --week-start, default Sunday)\n" +
+		"\tM: months\n" +
+		"\tq: quarters\n" +
+		"\ty: years\n" +
+		"\tw0-w6: a specific weekday for snapping (0=Sunday .. 6=Saturday),\n" +
+		"\t\talso selectable via the aliases sun/mon/tue/wed/thu/fri/sat\n\n" +
+		"\t--week-start <sun|mon|iso>\n" +
+		"\t\tWhich weekday the bare 'w' unit (above) snaps to with '@w';\n" +
+		"\t\trelative 'w' shifts always move by whole 7-day weeks.\n" +
+		"\t\tDefault is 'sun'. 'iso' snaps exactly like 'mon'; the ISO-8601\n" +
+		"\t\tweek numbers of the output format ('%V' / 'IW') are printed\n" +
+		"\t\tunder every --week-start setting.\n" +
+		"\t\tHas no effect on the explicit w0-w6 units.\n\n" +
+		"\t--base <time>\n" +
+		"\t\tThe base time for the calculation. If not specified, the current time is used.\n" +
+		"\t\tSupported formats:\n" +
+		"\t\t- RFC3339: '2023-10-27T10:00:00Z'\n" +
+		"\t\t- Simple Date: '2023-10-27'\n" +
+		"\t\t- Unix Time: '1698372000'\n" +
+		"\t\t- TZ-aware: 'TZ=Asia/Tokyo 2023-10-27T10:00:00'\n" +
+		"\t\t- Many other common layouts are auto-detected, e.g. RFC1123,\n" +
+		"\t\t  syslog ('Jan _2 15:04:05'), and '@<epoch>[.<fraction>]'.\n\n" +
+		"\t--input-format <layout>\n" +
+		"\t\tForces --base to be parsed with exactly this layout (Go, friendly,\n" +
+		"\t\tor strftime — auto-detected the same way as --format), instead of\n" +
+		"\t\ttrying the built-in list of layouts.\n\n" +
+		"\t--format <layout>\n" +
+		"\t\tThe output format for the final timestamp. The default is RFC3339.\n" +
+		"\t\tYou can use Go's time layout string (e.g., '2006-01-02 15:04:05',\n" +
+		"\t\ttime.RFC1123, ...) or a more intuitive format like 'YYYY/MM/DD hh:mm:ss'.\n" +
+		"\t\tstrftime-style directives (e.g. '%Y-%m-%d %H:%M:%S') are also supported.\n" +
+		"\t\tThe dialect is auto-detected: '%' means strftime, one of the friendly\n" +
+		"\t\tmetacharacters below other than M, D, a and IW means friendly,\n" +
+		"\t\totherwise it's used as a literal Go layout. --input-format uses the same auto-detection. Use\n" +
+		"\t\t--format-style to force a dialect for --format if it's ambiguous.\n" +
+		"\t\tYou can also specify 'unix' or 'epoch' to output as a Unix timestamp.\n\n" +
+		"\t--format-style <strftime|go|friendly>\n" +
+		"\t\tForces how --format is interpreted instead of auto-detecting.\n\n" +
+		"\t--earliest <operation>, --latest <operation>\n" +
+		"\t\tSwitch to range mode: compute both an earliest and a latest bound\n" +
+		"\t\tfrom the same base time, each using the --op grammar. Either flag\n" +
+		"\t\tmay be omitted and defaults to 'now'. It is an error for the\n" +
+		"\t\tcomputed earliest to be after the computed latest.\n" +
+		"\t\tExample: --earliest '-24h@h' --latest '@h'\n\n" +
+		"\t--output <range|earliest|latest|pair>\n" +
+		"\t\tIn range mode, selects what to print: 'earliest' or 'latest' print\n" +
+		"\t\tjust that bound; 'range'/'pair' (the default) print both, separated\n" +
+		"\t\tby --separator. With --format=json, both bounds are always printed\n" +
+		"\t\tas one JSON object, whichever --output is given.\n\n" +
+		"\t--separator <string>\n" +
+		"\t\tSeparator between earliest and latest in range mode. Default: a single space.\n\n" +
+		"\t--output-tz <timezone>\n" +
+		"\t\tSpecifies the timezone for the output timestamp.\n" +
+		"\t\tExample: 'Asia/Tokyo', 'America/New_York'.\n\n" +
+		"Supported Format Metacharacters:\n" +
+		"\tYYYY: 4-digit year\n" +
+		"\tYY:   2-digit year\n" +
+		"\tMM:   2-digit month\n" +
+		"\tM:    1-digit month\n" +
+		"\tDD:   2-digit day\n" +
+		"\tD:    1-digit day\n" +
+		"\thh:   24-hour\n" +
+		"\tmm:   minute\n" +
+		"\tss:   second\n" +
+		"\tSSS:  millisecond\n" +
+		"\tUUU:  microsecond\n" +
+		"\ta:    AM/PM\n" +
+		"\tTZ:   Timezone abbreviation (e.g., JST)\n" +
+		"\tZZ:   Timezone offset with colon (e.g., +09:00)\n" +
+		"\tZZZ:  Timezone offset without colon (e.g., +0900)\n" +
+		"\tIW:   ISO-8601 week number (01-53)\n\n" +
+		"Examples:\n" +
+		"  # Output current time in a more intuitive format\n" +
+		"  ./sdate --format 'YYYY/MM/DD hh:mm:ss'\n" +
+		"  # Output current time with milliseconds\n" +
+		"  ./sdate --format 'YYYY-MM-DD hh:mm:ss.SSS'\n" +
+		"  # Calculate 2 hours after a specified time in a specific timezone, then output in another timezone\n" +
+		"  ./sdate --op +2h --base 'TZ=America/New_York 2023-10-27T10:00:00' --output-tz Asia/Tokyo --format 'YYYY-MM-DD hh:mm:ss ZZ'\n" +
+		"  # Output the current time with timezone abbreviation\n" +
+		"  ./sdate --format 'YYYY-MM-DD hh:mm:ss TZ'\n" +
+		"  # Output the current time with timezone offset (with colon)\n" +
+		"  ./sdate --format 'YYYY-MM-DD hh:mm:ss ZZ'\n" +
+		"  # Output the current time with timezone offset (without colon)\n" +
+		"  ./sdate --format 'YYYY-MM-DD hh:mm:ss ZZZ'\n" +
+		"  # Output the current time using a strftime-style format\n" +
+		"  ./sdate --format '%Y-%m-%d %H:%M:%S'\n" +
+		"  # Output the last 24 hours as an earliest/latest pair\n" +
+		"  ./sdate --earliest '-24h@h' --latest '@h'\n" +
+		"  # Same, as a JSON object\n" +
+		"  ./sdate --earliest '-24h@h' --latest '@h' --format json"
+}
+
+func main() {
+	// Add --op, --format, --base, and --output-tz options
+	operation := flag.String("op", "", "The operation to perform (e.g., '-1d@d').")
+	outputFormat := flag.String("format", time.RFC3339, "Output timestamp format (Go time layout string)")
+	formatStyle := flag.String("format-style", "", "Dialect of --format: 'strftime', 'go', or 'friendly'. Default: auto-detect.")
+	baseTimeStr := flag.String("base", "", "Base time for calculation (e.g., '2023-10-27T10:00:00Z')")
+	inputFormat := flag.String("input-format", "", "Force --base to be parsed with this layout (Go, friendly, or strftime), instead of auto-detecting.")
+	outputTZ := flag.String("output-tz", "", "Timezone for the output (e.g., 'Asia/Tokyo')")
+	earliestOp := flag.String("earliest", "", "Earliest-bound operation; switches to range mode (e.g., '-24h@h').")
+	latestOp := flag.String("latest", "", "Latest-bound operation; switches to range mode (e.g., '@h').")
+	outputMode := flag.String("output", "range", "Range mode output: 'range', 'earliest', 'latest', or 'pair'.")
+	separator := flag.String("separator", " ", "Separator between earliest and latest in range mode.")
+	weekStart := flag.String("week-start", "sun", "Start-of-week for the bare 'w' unit: 'sun', 'mon', or 'iso'.")
+	showHelp := flag.Bool("help", false, "Show detailed help message")
+	showVersion := flag.Bool("version", false, "Show version information")
+
+	// Parse flags
+	flag.Parse()
+
+	// If --version flag is specified, show the version and exit
+	if *showVersion {
+		fmt.Printf("sdate version %s\n", version)
+		os.Exit(0)
+	}
+
+	// If --help flag is specified, show the help message and exit
+	if *showHelp {
+		fmt.Println(helpMessage())
+		os.Exit(0)
+	}
+
+	// Get the operation argument (a positional argument)
+	args := flag.Args()
+
+	// Make the operation optional
+	var op string
+	if len(args) > 0 {
+		op = args[0]
+	}
+
+	// If the --op flag is set, it takes precedence over the positional argument.
+	if *operation != "" {
+		op = *operation
+	}
+
+	// Use --base option for the base time, otherwise use the current time.
+	baseTime, err := sdate.ParseBaseWithFormat(*baseTimeStr, *inputFormat)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	style := sdate.FormatStyle(*formatStyle)
+	switch style {
+	case sdate.FormatStyleAuto, sdate.FormatStyleGo, sdate.FormatStyleFriendly, sdate.FormatStyleStrftime:
+	default:
+		fmt.Fprintf(os.Stderr, "Error: Invalid --format-style: %s (must be 'strftime', 'go', or 'friendly')\n", *formatStyle)
+		os.Exit(1)
+	}
+
+	weekStartSetting := sdate.WeekStart(*weekStart)
+	switch weekStartSetting {
+	case sdate.WeekStartSun, sdate.WeekStartMon, sdate.WeekStartISO:
+	default:
+		fmt.Fprintf(os.Stderr, "Error: Invalid --week-start: %s (must be 'sun', 'mon', or 'iso')\n", *weekStart)
+		os.Exit(1)
+	}
+
+	// --earliest/--latest switch to range mode.
+	if *earliestOp != "" || *latestOp != "" {
+		runRange(baseTime, *earliestOp, *latestOp, *outputMode, *separator, *outputFormat, style, *outputTZ, weekStartSetting)
+		return
+	}
+
+	// Parse the operation and apply it to the base time. With no operation
+	// specified, Parse/Apply are no-ops and the base time passes through.
+	spec, err := sdate.Parse(op)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	spec.WeekStart = weekStartSetting
+
+	calculatedTime, err := spec.Apply(baseTime)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error applying operation: %v\n", err)
+		os.Exit(1)
+	}
+
+	calculatedTime, err = applyOutputTZ(calculatedTime, *outputTZ)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println(sdate.FormatTime(calculatedTime, *outputFormat, style))
+}
+
+// applyOutputTZ converts t to tzName if it is non-empty, otherwise returns t unchanged.
+func applyOutputTZ(t time.Time, tzName string) (time.Time, error) {
+	if tzName == "" {
+		return t, nil
+	}
+	loc, err := time.LoadLocation(tzName)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid output timezone name: %s", tzName)
+	}
+	return t.In(loc), nil
+}
+
+// rangeJSON is the --format=json payload for range mode.
+type rangeJSON struct {
+	Earliest      string `json:"earliest"`
+	Latest        string `json:"latest"`
+	EarliestEpoch int64  `json:"earliest_epoch"`
+	LatestEpoch   int64  `json:"latest_epoch"`
+}
+
+// runRange computes and prints the earliest/latest bound pair for range mode.
+func runRange(baseTime time.Time, earliestOp, latestOp, outputMode, separator, outputFormat string, style sdate.FormatStyle, outputTZ string, weekStart sdate.WeekStart) {
+	if earliestOp == "" {
+		earliestOp = "now"
+	}
+	if latestOp == "" {
+		latestOp = "now"
+	}
+
+	rng, err := sdate.ParseRange(baseTime, earliestOp, latestOp, weekStart)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	earliest, err := applyOutputTZ(rng.Earliest, outputTZ)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	latest, err := applyOutputTZ(rng.Latest, outputTZ)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	switch outputMode {
+	case "range", "pair", "earliest", "latest":
+	default:
+		fmt.Fprintf(os.Stderr, "Error: Invalid --output: %s (must be 'range', 'earliest', 'latest', or 'pair')\n", outputMode)
+		os.Exit(1)
+	}
+
+	if strings.ToLower(outputFormat) == "json" {
+		// The JSON fields are always RFC3339, regardless of --format-style:
+		// that flag governs how the user's --format layout is interpreted,
+		// and doesn't apply here since time.RFC3339 is always a literal Go
+		// layout, never a strftime or friendly pattern.
+		payload := rangeJSON{
+			Earliest:      earliest.Format(time.RFC3339),
+			Latest:        latest.Format(time.RFC3339),
+			EarliestEpoch: earliest.Unix(),
+			LatestEpoch:   latest.Unix(),
+		}
+		encoded, err := json.Marshal(payload)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(encoded))
+		return
+	}
+
+	earliestStr := sdate.FormatTime(earliest, outputFormat, style)
+	latestStr := sdate.FormatTime(latest, outputFormat, style)
+
+	switch outputMode {
+	case "earliest":
+		fmt.Println(earliestStr)
+	case "latest":
+		fmt.Println(latestStr)
+	default: // "range" or "pair"
+		fmt.Println(earliestStr + separator + latestStr)
+	}
+}
@@ -0,0 +1,172 @@
+package sdate
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultBaseLayouts are the layouts ParseBase tries in order, until one
+// succeeds. It covers Go's standard reference-time constants plus common
+// variants seen in logs (with/without 'T', with/without fractional
+// seconds, and syslog-style dates).
+var DefaultBaseLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC822Z,
+	time.RFC822,
+	time.RFC850,
+	time.ANSIC,
+	time.UnixDate,
+	time.RubyDate,
+	time.StampNano,
+	time.StampMicro,
+	time.StampMilli,
+	time.Stamp,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"Jan _2 15:04:05.999999999", // syslog, with fractional seconds
+	"Jan _2 15:04:05",           // syslog
+}
+
+// baseTimeTZPrefix matches the "TZ=<zone> <time>" prefix accepted by ParseBase.
+var baseTimeTZPrefix = regexp.MustCompile(`^TZ=(?P<tz>[\w\/]+)\s+(?P<time>.+)$`)
+
+// epochRe matches a bare or '@'-prefixed Unix timestamp, optionally with a
+// fractional-seconds part (Elasticsearch-style, e.g. "@1698372000.123").
+var epochRe = regexp.MustCompile(`^@?(-?\d+)(\.\d+)?$`)
+
+// ParseBase parses a base-time string as accepted by the --base flag.
+// If input is empty, the current time is returned. Otherwise input is
+// matched against DefaultBaseLayouts in order, with a Unix timestamp
+// (seconds, milliseconds, microseconds, or nanoseconds, auto-detected by
+// digit count, or "@<seconds>[.<fraction>]") tried first. Any form may be
+// prefixed with "TZ=<zone> " to parse the time in that zone.
+func ParseBase(input string) (time.Time, error) {
+	return ParseBaseWithFormat(input, "")
+}
+
+// ParseBaseWithFormat is like ParseBase, but if format is non-empty it is
+// used as the only layout to try, instead of DefaultBaseLayouts. format
+// may be a Go time layout, a friendly 'YYYY-MM-DD'-style pattern (see
+// ConvertFormat), or a strftime pattern (see FormatStrftime) — the dialect
+// is auto-detected the same way FormatTime detects it.
+func ParseBaseWithFormat(input, format string) (time.Time, error) {
+	if input == "" {
+		return time.Now(), nil
+	}
+
+	body := input
+	var loc *time.Location
+
+	if m := baseTimeTZPrefix.FindStringSubmatch(input); m != nil {
+		tzName := m[baseTimeTZPrefix.SubexpIndex("tz")]
+		body = m[baseTimeTZPrefix.SubexpIndex("time")]
+
+		l, err := time.LoadLocation(tzName)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid timezone name: %s", tzName)
+		}
+		loc = l
+	}
+
+	if format != "" {
+		t, err := parseWithLayout(body, resolveInputLayout(format), loc)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid base time %q for --input-format %q: %w", body, format, err)
+		}
+		return t, nil
+	}
+
+	if t, ok := parseEpoch(body); ok {
+		if loc != nil {
+			t = t.In(loc)
+		}
+		return t, nil
+	}
+
+	for _, layout := range DefaultBaseLayouts {
+		if t, err := parseWithLayout(body, layout, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, errors.New("invalid base time format: no matching layout for " + body)
+}
+
+// parseWithLayout parses body with layout, in loc if given, otherwise in
+// whatever zone layout itself specifies (UTC if none).
+func parseWithLayout(body, layout string, loc *time.Location) (time.Time, error) {
+	if loc != nil {
+		return time.ParseInLocation(layout, body, loc)
+	}
+	return time.Parse(layout, body)
+}
+
+// resolveInputLayout turns a user-supplied --input-format value into a Go
+// time layout, auto-detecting its dialect the same way FormatTime does: a
+// '%' directive means strftime, one of ConvertFormat's distinctive
+// metacharacters (see IsFriendlyFormat) means the friendly dialect, and
+// anything else is assumed to already be a Go layout and is used as-is —
+// otherwise idiomatic layouts built from Go's month/weekday/zone names
+// (time.RFC1123, time.ANSIC, time.UnixDate, ...) would be corrupted by
+// ConvertFormat's blind substring replacement.
+func resolveInputLayout(format string) string {
+	if IsStrftimeFormat(format) {
+		return strftimeToLayout(format)
+	}
+	if IsFriendlyFormat(format) {
+		return ConvertFormat(format)
+	}
+	return format
+}
+
+// parseEpoch parses body as a Unix timestamp: "@<seconds>[.<fraction>]",
+// or a bare integer whose unit (seconds/milliseconds/microseconds/
+// nanoseconds) is inferred from its digit count.
+func parseEpoch(body string) (time.Time, bool) {
+	m := epochRe.FindStringSubmatch(body)
+	if m == nil {
+		return time.Time{}, false
+	}
+
+	whole := m[1]
+	if m[2] != "" {
+		sec, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil {
+			return time.Time{}, false
+		}
+		frac := m[2][1:] // drop the leading '.'
+		frac = (frac + "000000000")[:9]
+		nsec, err := strconv.ParseInt(frac, 10, 64)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return time.Unix(sec, nsec), true
+	}
+
+	n, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	digits := len(strings.TrimPrefix(whole, "-"))
+	switch {
+	case digits <= 10:
+		return time.Unix(n, 0), true
+	case digits <= 13:
+		return time.UnixMilli(n), true
+	case digits <= 16:
+		return time.UnixMicro(n), true
+	default:
+		return time.Unix(0, n), true
+	}
+}
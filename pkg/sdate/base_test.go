@@ -0,0 +1,119 @@
+package sdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseBase(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"Empty uses now", "", time.Time{}, false},
+		{"Unix seconds", "1698372000", time.Unix(1698372000, 0), false},
+		{"Unix millis", "1698372000123", time.UnixMilli(1698372000123), false},
+		{"Unix micros", "1698372000123456", time.UnixMicro(1698372000123456), false},
+		{"Unix nanos", "1698372000123456789", time.Unix(0, 1698372000123456789), false},
+		{"Elasticsearch-style epoch with fraction", "@1698372000.123", time.Unix(1698372000, 123000000), false},
+		{"RFC3339", "2023-10-27T10:00:00Z", time.Date(2023, 10, 27, 10, 0, 0, 0, time.UTC), false},
+		{"Space-separated with fractional seconds", "2023-10-27 10:00:00.500", time.Date(2023, 10, 27, 10, 0, 0, 500000000, time.UTC), false},
+		{"Simple date", "2023-10-27", time.Date(2023, 10, 27, 0, 0, 0, 0, time.UTC), false},
+		{"RFC1123", "Fri, 27 Oct 2023 10:00:00 UTC", time.Date(2023, 10, 27, 10, 0, 0, 0, time.UTC), false},
+		{"Syslog", "Oct 27 10:00:00", time.Date(0, time.October, 27, 10, 0, 0, 0, time.UTC), false},
+		{"Invalid", "not-a-time", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBase(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseBase() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.name == "Empty uses now" {
+				return
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("ParseBase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseBaseTZ(t *testing.T) {
+	got, err := ParseBase("TZ=Asia/Tokyo 2023-10-27T10:00:00")
+	if err != nil {
+		t.Fatalf("ParseBase() error = %v", err)
+	}
+	loc, _ := time.LoadLocation("Asia/Tokyo")
+	want := time.Date(2023, 10, 27, 10, 0, 0, 0, loc)
+	if !got.Equal(want) {
+		t.Errorf("ParseBase() = %v, want %v", got, want)
+	}
+}
+
+// TestParseBaseTZEverySupportedLayout checks that the "TZ=<zone> " prefix
+// is honored for every layout in DefaultBaseLayouts, not just whichever one
+// a single hand-picked example happens to exercise.
+func TestParseBaseTZEverySupportedLayout(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+	ref := time.Date(2023, 10, 27, 10, 30, 45, 0, loc)
+
+	for _, layout := range DefaultBaseLayouts {
+		t.Run(layout, func(t *testing.T) {
+			body := ref.Format(layout)
+
+			want, err := time.ParseInLocation(layout, body, loc)
+			if err != nil {
+				t.Fatalf("time.ParseInLocation(%q, %q) error = %v", layout, body, err)
+			}
+
+			got, err := ParseBase("TZ=Asia/Tokyo " + body)
+			if err != nil {
+				t.Fatalf("ParseBase() error = %v", err)
+			}
+			if !got.Equal(want) {
+				t.Errorf("ParseBase() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestParseBaseWithFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		format string
+		want   time.Time
+	}{
+		{"Go layout", "27/10/2023", "02/01/2006", time.Date(2023, 10, 27, 0, 0, 0, 0, time.UTC)},
+		{"Friendly layout", "2023/10/27 10:00:00", "YYYY/MM/DD hh:mm:ss", time.Date(2023, 10, 27, 10, 0, 0, 0, time.UTC)},
+		{"Strftime layout", "2023-10-27 10:00:00", "%Y-%m-%d %H:%M:%S", time.Date(2023, 10, 27, 10, 0, 0, 0, time.UTC)},
+		{"Go layout built from reference-time names (RFC1123)", "Fri, 27 Oct 2023 10:00:00 UTC", time.RFC1123, time.Date(2023, 10, 27, 10, 0, 0, 0, time.UTC)},
+		{"Go layout built from reference-time names (ANSIC)", "Fri Oct 27 10:00:00 2023", time.ANSIC, time.Date(2023, 10, 27, 10, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBaseWithFormat(tt.input, tt.format)
+			if err != nil {
+				t.Fatalf("ParseBaseWithFormat() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseBaseWithFormat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("mismatched layout errors", func(t *testing.T) {
+		if _, err := ParseBaseWithFormat("2023-10-27", "%H:%M:%S"); err == nil {
+			t.Error("ParseBaseWithFormat() error = nil, want error")
+		}
+	})
+}
@@ -0,0 +1,269 @@
+package sdate
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidFormat is returned when the input format is invalid.
+var ErrInvalidFormat = errors.New("invalid format: must be a sequence of [+|-]<quantity><unit> and @<unit> tokens, e.g. '-1d@d', '@h+2h', or '-mon@mon'")
+
+// Op is a single relative or snap operation within a Spec, applied in order.
+type Op struct {
+	// Unit is the canonical unit code: one of "s", "m", "h", "d", "w", "M",
+	// "y", "q" (quarter), or "w0".."w6" (snap to a specific weekday, 0=Sunday).
+	Unit string
+	// Snap is true for an "@unit" token, false for a "[+-]<n>unit" token.
+	Snap bool
+	// Delta is the signed quantity for a relative token; unused for snaps.
+	Delta int
+}
+
+// Spec holds an ordered sequence of Splunk-like timestamp operations.
+type Spec struct {
+	Ops       []Op
+	Operation string
+	// WeekStart selects the weekday the bare "w" unit snaps to.
+	// The zero value behaves like WeekStartSun.
+	WeekStart WeekStart
+}
+
+// WeekStart selects which weekday is considered the start of the week for
+// the bare "@w" snap. It has no effect on relative "w" shifts, which always
+// move by whole 7-day weeks, nor on "w0".."w6", which always refer to an
+// explicit weekday regardless of this setting.
+type WeekStart string
+
+const (
+	// WeekStartSun treats Sunday as the start of the week. This is the
+	// default, matching Splunk's native "@w" behavior.
+	WeekStartSun WeekStart = "sun"
+	// WeekStartMon treats Monday as the start of the week.
+	WeekStartMon WeekStart = "mon"
+	// WeekStartISO also treats Monday as the start of the week; for "@w"
+	// snapping it behaves identically to WeekStartMon. The formatter's
+	// ISO-8601 week directives ("%V", "IW") do not read this setting and
+	// print ISO week numbers under every WeekStart.
+	WeekStartISO WeekStart = "iso"
+)
+
+// weekday returns the time.Weekday that starts the week under ws. The zero
+// value (unset) is treated as WeekStartSun.
+func (ws WeekStart) weekday() (time.Weekday, error) {
+	switch ws {
+	case "", WeekStartSun:
+		return time.Sunday, nil
+	case WeekStartMon, WeekStartISO:
+		return time.Monday, nil
+	default:
+		return 0, fmt.Errorf("unknown week start: %s (must be 'sun', 'mon', or 'iso')", ws)
+	}
+}
+
+// weekdayAliases maps the bare weekday names Splunk accepts (in snap
+// position) to their canonical w0 (Sunday) .. w6 (Saturday) unit code.
+var weekdayAliases = map[string]string{
+	"sun": "w0",
+	"mon": "w1",
+	"tue": "w2",
+	"wed": "w3",
+	"thu": "w4",
+	"fri": "w5",
+	"sat": "w6",
+}
+
+var (
+	snapTokenRe       = regexp.MustCompile(`^@(w[0-6]|mon|tue|wed|thu|fri|sat|sun|q|[smhdwMy])`)
+	relTokenRe        = regexp.MustCompile(`^([+-]\d+)(q|[smhdwMy])`)
+	relWeekdayTokenRe = regexp.MustCompile(`^([+-])(mon|tue|wed|thu|fri|sat|sun)`)
+)
+
+// Parse parses the input string and returns a Spec.
+//
+// input is a sequence of tokens consumed left-to-right and applied in
+// order: "@<unit>" snaps to the start of that unit (e.g. "@d", "@q",
+// "@w1"/"@mon" for snap-to-Monday), and "[+|-]<n><unit>" shifts by n units
+// (e.g. "-1d", "+2h"). A bare "[+|-]<weekday>" with no count (e.g. "-mon",
+// "+fri") jumps to the previous/next occurrence of that weekday. Tokens may
+// be freely chained, e.g. "-1d@d+8h" or "-mon@mon". The literal "now" and
+// the empty string are accepted as a no-op. If the input string is empty,
+// it returns an empty operation without an error.
+func Parse(input string) (*Spec, error) {
+	spec := &Spec{Operation: input}
+
+	if input == "" || input == "now" {
+		return spec, nil
+	}
+
+	remaining := input
+	for remaining != "" {
+		op, consumed, err := parseNextOp(remaining)
+		if err != nil {
+			return nil, err
+		}
+		spec.Ops = append(spec.Ops, op)
+		remaining = remaining[consumed:]
+	}
+
+	return spec, nil
+}
+
+// parseNextOp parses a single leading token from s, returning the Op and
+// the number of bytes consumed.
+func parseNextOp(s string) (Op, int, error) {
+	if m := snapTokenRe.FindStringSubmatch(s); m != nil {
+		unit := m[1]
+		if alias, ok := weekdayAliases[unit]; ok {
+			unit = alias
+		}
+		return Op{Unit: unit, Snap: true}, len(m[0]), nil
+	}
+
+	if m := relTokenRe.FindStringSubmatch(s); m != nil {
+		value, err := strconv.Atoi(m[1])
+		if err != nil {
+			return Op{}, 0, fmt.Errorf("invalid quantity: %s", m[1])
+		}
+		return Op{Unit: m[2], Snap: false, Delta: value}, len(m[0]), nil
+	}
+
+	// Bare weekday relative, e.g. "-mon" / "+fri": jump to the
+	// previous/next occurrence of that weekday (no explicit count).
+	if m := relWeekdayTokenRe.FindStringSubmatch(s); m != nil {
+		unit := weekdayAliases[m[2]]
+		delta := 1
+		if m[1] == "-" {
+			delta = -1
+		}
+		return Op{Unit: unit, Snap: false, Delta: delta}, len(m[0]), nil
+	}
+
+	return Op{}, 0, ErrInvalidFormat
+}
+
+// Apply applies the spec's operations, in order, to the given time.
+func (spec *Spec) Apply(t time.Time) (time.Time, error) {
+	startDow, err := spec.WeekStart.weekday()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	result := t
+
+	for _, op := range spec.Ops {
+		if op.Snap {
+			result, err = applySnap(result, op.Unit, startDow)
+		} else {
+			result, err = applyRelative(result, op.Unit, op.Delta)
+		}
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return result, nil
+}
+
+// applySnap snaps t to the start of the given unit. startDow is the
+// week-start weekday used for the bare "w" unit (see WeekStart); it has no
+// effect on the explicit "w0".."w6" weekday units.
+func applySnap(t time.Time, unit string, startDow time.Weekday) (time.Time, error) {
+	if strings.HasPrefix(unit, "w") && len(unit) == 2 {
+		dow, err := strconv.Atoi(unit[1:])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown snap unit: %s", unit)
+		}
+		return snapToWeekday(t, time.Weekday(dow)), nil
+	}
+
+	switch unit {
+	case "s":
+		return t.Truncate(time.Second), nil
+	case "m":
+		return t.Truncate(time.Minute), nil
+	case "h":
+		return t.Truncate(time.Hour), nil
+	case "d":
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()), nil
+	case "w":
+		// Snap to the beginning of the week, per startDow.
+		return snapToWeekday(t, startDow), nil
+	case "M":
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()), nil
+	case "q":
+		quarterMonth := time.Month(((int(t.Month())-1)/3)*3 + 1)
+		return time.Date(t.Year(), quarterMonth, 1, 0, 0, 0, 0, t.Location()), nil
+	case "y":
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location()), nil
+	default:
+		return time.Time{}, fmt.Errorf("unknown snap unit: %s", unit)
+	}
+}
+
+// snapToWeekday snaps t to the beginning of the day, then back to the most
+// recent occurrence (inclusive) of target.
+func snapToWeekday(t time.Time, target time.Weekday) time.Time {
+	dayStart := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	daysToSubtract := (int(dayStart.Weekday()) - int(target) + 7) % 7
+	return dayStart.AddDate(0, 0, -daysToSubtract)
+}
+
+// applyRelative shifts t by value units.
+func applyRelative(t time.Time, unit string, value int) (time.Time, error) {
+	if strings.HasPrefix(unit, "w") && len(unit) == 2 {
+		dow, err := strconv.Atoi(unit[1:])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown relative unit: %s", unit)
+		}
+		return relativeWeekday(t, time.Weekday(dow), value), nil
+	}
+
+	switch unit {
+	case "s":
+		return t.Add(time.Duration(value) * time.Second), nil
+	case "m":
+		return t.Add(time.Duration(value) * time.Minute), nil
+	case "h":
+		return t.Add(time.Duration(value) * time.Hour), nil
+	case "d":
+		return t.AddDate(0, 0, value), nil
+	case "w":
+		return t.AddDate(0, 0, value*7), nil
+	case "M":
+		return t.AddDate(0, value, 0), nil
+	case "q":
+		return t.AddDate(0, value*3, 0), nil
+	case "y":
+		return t.AddDate(value, 0, 0), nil
+	default:
+		return time.Time{}, fmt.Errorf("unknown relative unit: %s", unit)
+	}
+}
+
+// relativeWeekday walks t forward (steps > 0) or backward (steps < 0) to
+// the nth occurrence of target, never returning t itself.
+func relativeWeekday(t time.Time, target time.Weekday, steps int) time.Time {
+	if steps == 0 {
+		return t
+	}
+
+	direction := 1
+	count := steps
+	if steps < 0 {
+		direction = -1
+		count = -steps
+	}
+
+	result := t
+	for i := 0; i < count; i++ {
+		result = result.AddDate(0, 0, direction)
+		for result.Weekday() != target {
+			result = result.AddDate(0, 0, direction)
+		}
+	}
+	return result
+}
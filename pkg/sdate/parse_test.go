@@ -0,0 +1,196 @@
+package sdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []Op
+		wantErr bool
+	}{
+		{"Empty input", "", nil, false},
+		{"now is a no-op", "now", nil, false},
+		{"Snap only", "@d", []Op{{Unit: "d", Snap: true}}, false},
+		{"Relative only", "-1h", []Op{{Unit: "h", Delta: -1}}, false},
+		{"Relative and snap", "-1d@d", []Op{{Unit: "d", Delta: -1}, {Unit: "d", Snap: true}}, false},
+		{"Snap and relative", "@h+2h", []Op{{Unit: "h", Snap: true}, {Unit: "h", Delta: 2}}, false},
+		{"Chained ops", "-1d@d+8h", []Op{{Unit: "d", Delta: -1}, {Unit: "d", Snap: true}, {Unit: "h", Delta: 8}}, false},
+		{"Relative month then weekday snap alias", "-1M@mon", []Op{{Unit: "M", Delta: -1}, {Unit: "w1", Snap: true}}, false},
+		{"Bare weekday relative then snap", "-mon@mon", []Op{{Unit: "w1", Delta: -1}, {Unit: "w1", Snap: true}}, false},
+		{"Quarter snap", "@q", []Op{{Unit: "q", Snap: true}}, false},
+		{"Relative quarter", "-1q@q", []Op{{Unit: "q", Delta: -1}, {Unit: "q", Snap: true}}, false},
+		{"Weekday snap by number", "@w1", []Op{{Unit: "w1", Snap: true}}, false},
+		{"Long relative quantity", "-60m@m+30s", []Op{{Unit: "m", Delta: -60}, {Unit: "m", Snap: true}, {Unit: "s", Delta: 30}}, false},
+		{"Invalid input", "invalid", nil, true},
+		{"Invalid quantity", "-xh@d", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if len(spec.Ops) != len(tt.want) {
+				t.Fatalf("Parse() ops = %+v, want %+v", spec.Ops, tt.want)
+			}
+			for i, op := range spec.Ops {
+				if op != tt.want[i] {
+					t.Errorf("Parse() op[%d] = %+v, want %+v", i, op, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSpecApply(t *testing.T) {
+	// 2023-10-27 is a Friday.
+	baseTime := time.Date(2023, 10, 27, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		spec    *Spec
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name: "Snap to day",
+			spec: &Spec{Ops: []Op{{Unit: "d", Snap: true}}},
+			want: time.Date(2023, 10, 27, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Add 2 hours",
+			spec: &Spec{Ops: []Op{{Unit: "h", Delta: 2}}},
+			want: time.Date(2023, 10, 27, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "Subtract 1 day and snap to day",
+			spec: &Spec{Ops: []Op{{Unit: "d", Delta: -1}, {Unit: "d", Snap: true}}},
+			want: time.Date(2023, 10, 26, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Snap to quarter",
+			spec: &Spec{Ops: []Op{{Unit: "q", Snap: true}}},
+			want: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Relative quarter then snap",
+			spec: &Spec{Ops: []Op{{Unit: "q", Delta: -1}, {Unit: "q", Snap: true}}},
+			want: time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Snap to specific weekday (same day)",
+			spec: &Spec{Ops: []Op{{Unit: "w5", Snap: true}}}, // Friday
+			want: time.Date(2023, 10, 27, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Snap to specific weekday (earlier in week)",
+			spec: &Spec{Ops: []Op{{Unit: "w1", Snap: true}}}, // Monday
+			want: time.Date(2023, 10, 23, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Chained relative then snap then relative",
+			spec: &Spec{Ops: []Op{
+				{Unit: "d", Delta: -1},
+				{Unit: "d", Snap: true},
+				{Unit: "h", Delta: 8},
+			}},
+			want: time.Date(2023, 10, 26, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Bare weekday relative: previous Monday",
+			spec: &Spec{Ops: []Op{{Unit: "w1", Delta: -1}}},
+			want: time.Date(2023, 10, 23, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "Bare weekday relative then snap to Monday",
+			spec: &Spec{Ops: []Op{{Unit: "w1", Delta: -1}, {Unit: "w1", Snap: true}}},
+			want: time.Date(2023, 10, 23, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "Unknown snap unit",
+			spec:    &Spec{Ops: []Op{{Unit: "x", Snap: true}}},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown relative unit",
+			spec:    &Spec{Ops: []Op{{Unit: "x", Delta: 1}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.spec.Apply(baseTime)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Spec.Apply() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("Spec.Apply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpecApplyWeekStart(t *testing.T) {
+	tests := []struct {
+		name string
+		base time.Time
+		ws   WeekStart
+		want time.Time
+	}{
+		{
+			name: "Default week-start snaps Sunday to itself",
+			base: time.Date(2023, 10, 22, 10, 0, 0, 0, time.UTC), // a Sunday
+			ws:   "",
+			want: time.Date(2023, 10, 22, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Explicit sun week-start snaps Sunday to itself",
+			base: time.Date(2023, 10, 22, 10, 0, 0, 0, time.UTC), // a Sunday
+			ws:   WeekStartSun,
+			want: time.Date(2023, 10, 22, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "mon week-start snaps mid-week back to Monday",
+			base: time.Date(2023, 10, 27, 10, 0, 0, 0, time.UTC), // a Friday
+			ws:   WeekStartMon,
+			want: time.Date(2023, 10, 23, 0, 0, 0, 0, time.UTC), // preceding Monday
+		},
+		{
+			name: "iso week-start across a year boundary",
+			base: time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC), // a Friday, ISO week 53 of 2020
+			ws:   WeekStartISO,
+			want: time.Date(2020, 12, 28, 0, 0, 0, 0, time.UTC), // the Monday starting that week
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &Spec{Ops: []Op{{Unit: "w", Snap: true}}, WeekStart: tt.ws}
+			got, err := spec.Apply(tt.base)
+			if err != nil {
+				t.Fatalf("Spec.Apply() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Spec.Apply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("invalid week-start errors", func(t *testing.T) {
+		spec := &Spec{Ops: []Op{{Unit: "w", Snap: true}}, WeekStart: "bogus"}
+		if _, err := spec.Apply(time.Now()); err == nil {
+			t.Error("Spec.Apply() error = nil, want error")
+		}
+	})
+}
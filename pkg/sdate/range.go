@@ -0,0 +1,46 @@
+package sdate
+
+import (
+	"fmt"
+	"time"
+)
+
+// Range holds a pair of computed timestamps bounding a time range, as used
+// by Splunk's earliest/latest search modifiers.
+type Range struct {
+	Earliest time.Time
+	Latest   time.Time
+}
+
+// ParseRange parses earliestOp and latestOp as Spec operations, applies
+// each to base, and returns the resulting Range. weekStart is used for the
+// bare "w" unit in either operation (see WeekStart). It returns an error
+// if either operation is invalid, or if the resulting earliest time is
+// after the resulting latest time.
+func ParseRange(base time.Time, earliestOp, latestOp string, weekStart WeekStart) (*Range, error) {
+	earliestSpec, err := Parse(earliestOp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --earliest: %w", err)
+	}
+	earliestSpec.WeekStart = weekStart
+	earliest, err := earliestSpec.Apply(base)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --earliest: %w", err)
+	}
+
+	latestSpec, err := Parse(latestOp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --latest: %w", err)
+	}
+	latestSpec.WeekStart = weekStart
+	latest, err := latestSpec.Apply(base)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --latest: %w", err)
+	}
+
+	if earliest.After(latest) {
+		return nil, fmt.Errorf("earliest (%s) is after latest (%s)", earliest.Format(time.RFC3339), latest.Format(time.RFC3339))
+	}
+
+	return &Range{Earliest: earliest, Latest: latest}, nil
+}
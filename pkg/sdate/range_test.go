@@ -0,0 +1,43 @@
+package sdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRange(t *testing.T) {
+	base := time.Date(2023, 10, 27, 10, 30, 0, 0, time.UTC)
+
+	t.Run("valid range", func(t *testing.T) {
+		rng, err := ParseRange(base, "-24h@h", "@h", WeekStartSun)
+		if err != nil {
+			t.Fatalf("ParseRange() error = %v", err)
+		}
+		wantEarliest := time.Date(2023, 10, 26, 10, 0, 0, 0, time.UTC)
+		wantLatest := time.Date(2023, 10, 27, 10, 0, 0, 0, time.UTC)
+		if !rng.Earliest.Equal(wantEarliest) {
+			t.Errorf("Earliest = %v, want %v", rng.Earliest, wantEarliest)
+		}
+		if !rng.Latest.Equal(wantLatest) {
+			t.Errorf("Latest = %v, want %v", rng.Latest, wantLatest)
+		}
+	})
+
+	t.Run("earliest after latest is an error", func(t *testing.T) {
+		if _, err := ParseRange(base, "now", "-1h", WeekStartSun); err == nil {
+			t.Error("ParseRange() error = nil, want error")
+		}
+	})
+
+	t.Run("invalid earliest operation", func(t *testing.T) {
+		if _, err := ParseRange(base, "invalid", "now", WeekStartSun); err == nil {
+			t.Error("ParseRange() error = nil, want error")
+		}
+	})
+
+	t.Run("invalid latest operation", func(t *testing.T) {
+		if _, err := ParseRange(base, "now", "invalid", WeekStartSun); err == nil {
+			t.Error("ParseRange() error = nil, want error")
+		}
+	})
+}
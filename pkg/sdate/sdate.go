@@ -0,0 +1,131 @@
+// Package sdate implements a Splunk-like relative time grammar: parsing
+// relative/snap expressions (e.g. "-1d@d"), applying them to a base time,
+// parsing flexible base-time strings, and converting user-friendly format
+// strings into Go time layouts.
+//
+// It is used by the sdate command-line tool, but is also safe to import
+// directly from other Go programs (log pipelines, test harnesses, query
+// engines) that need the same relative-time semantics without shelling out.
+package sdate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConvertFormat converts a user-friendly format string to a Go time layout string.
+func ConvertFormat(userFormat string) string {
+	replacementsList := []struct{ from, to string }{
+		{"YYYY", "2006"},
+		{"YY", "06"},
+		{"MM", "01"},
+		{"M", "1"},
+		{"DD", "02"},
+		{"D", "2"},
+		{"hh", "15"},
+		{"mm", "04"},
+		{"ss", "05"},
+		{"SSS", "000"},
+		{"UUU", "000000"},
+		{"a", "pm"},
+		{"TZ", "MST"},
+		{"ZZZ", "-0700"},
+		{"ZZ", "-07:00"},
+	}
+
+	// Replace the longest patterns first to avoid partial replacements (e.g., YYYY before YY)
+	for _, r := range replacementsList {
+		userFormat = strings.ReplaceAll(userFormat, r.from, r.to)
+	}
+
+	return userFormat
+}
+
+// FormatStyle selects which dialect a format string given to FormatTime is
+// written in.
+type FormatStyle string
+
+const (
+	// FormatStyleAuto picks strftime when format contains a '%' directive;
+	// otherwise it picks the friendly dialect only if format actually
+	// contains one of its metacharacters (see IsFriendlyFormat), and
+	// falls back to treating format as a literal Go time layout.
+	FormatStyleAuto FormatStyle = ""
+	// FormatStyleGo treats format as a Go time layout string, used as-is.
+	FormatStyleGo FormatStyle = "go"
+	// FormatStyleFriendly treats format as the YYYY/MM/DD-style metacharacters
+	// understood by ConvertFormat.
+	FormatStyleFriendly FormatStyle = "friendly"
+	// FormatStyleStrftime treats format as a POSIX/GNU strftime pattern,
+	// understood by FormatStrftime.
+	FormatStyleStrftime FormatStyle = "strftime"
+)
+
+// friendlyTokens are the metacharacters ConvertFormat rewrites. IsFriendlyFormat
+// uses these to decide whether a format string is actually written in the
+// friendly dialect, as opposed to a Go time layout that merely happens to
+// share some of the same letters (e.g. "Mon", "Jan", "MST" in
+// time.RFC1123).
+var friendlyTokens = []string{"YYYY", "YY", "MM", "DD", "hh", "mm", "ss", "SSS", "UUU", "TZ", "ZZZ", "ZZ"}
+
+// IsFriendlyFormat reports whether format contains at least one of the
+// friendly dialect's distinctive metacharacters. ConvertFormat's
+// replacements are blind substring substitutions (e.g. a lone "M" becomes
+// "1"), so running it on an arbitrary Go layout — most of which are built
+// from month/weekday names and zone abbreviations that contain those same
+// letters — silently mangles it instead of leaving it alone. Auto-detect
+// call sites should only treat a format as friendly when this returns true;
+// otherwise it should be used as a literal Go layout.
+func IsFriendlyFormat(format string) bool {
+	for _, tok := range friendlyTokens {
+		if strings.Contains(format, tok) {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatTime renders t using format, interpreted according to style. The
+// special format values "unix" and "epoch" (case-insensitive) always print
+// the Unix timestamp, regardless of style.
+func FormatTime(t time.Time, format string, style FormatStyle) string {
+	if lower := strings.ToLower(format); lower == "unix" || lower == "epoch" {
+		return strconv.FormatInt(t.Unix(), 10)
+	}
+
+	switch style {
+	case FormatStyleGo:
+		return t.Format(format)
+	case FormatStyleStrftime:
+		return FormatStrftime(t, format)
+	case FormatStyleFriendly:
+		return formatFriendly(t, format)
+	default:
+		if IsStrftimeFormat(format) {
+			return FormatStrftime(t, format)
+		}
+		if IsFriendlyFormat(format) {
+			return formatFriendly(t, format)
+		}
+		return t.Format(format)
+	}
+}
+
+// formatFriendly renders t using the friendly YYYY/MM/DD-style dialect. In
+// addition to the ConvertFormat metacharacters, it supports "IW" for the
+// zero-padded ISO-8601 week number (01-53). "IW" is substituted into the
+// rendered output rather than the layout string itself: neither 'I' nor
+// 'W' is a Go reference-time token, so it passes through t.Format
+// untouched, but splicing raw digits into the layout beforehand would
+// risk colliding with Go's single-digit time tokens (e.g. "5" for an
+// unpadded second).
+func formatFriendly(t time.Time, format string) string {
+	out := t.Format(ConvertFormat(format))
+	if strings.Contains(format, "IW") {
+		_, isoWeek := t.ISOWeek()
+		out = strings.ReplaceAll(out, "IW", fmt.Sprintf("%02d", isoWeek))
+	}
+	return out
+}
@@ -0,0 +1,48 @@
+package sdate
+
+import (
+	"testing"
+)
+
+func TestConvertFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		userFormat string
+		want       string
+	}{
+		{"YYYY/MM/DD hh:mm:ss", "YYYY/MM/DD hh:mm:ss", "2006/01/02 15:04:05"},
+		{"With milliseconds", "YYYY-MM-DD hh:mm:ss.SSS", "2006-01-02 15:04:05.000"},
+		{"With timezone", "YYYY-MM-DD hh:mm:ss TZ", "2006-01-02 15:04:05 MST"},
+		{"With timezone offset", "YYYY-MM-DD hh:mm:ss ZZ", "2006-01-02 15:04:05 -07:00"},
+		{"With timezone offset no colon", "YYYY-MM-DD hh:mm:ss ZZZ", "2006-01-02 15:04:05 -0700"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertFormat(tt.userFormat); got != tt.want {
+				t.Errorf("ConvertFormat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsFriendlyFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		want   bool
+	}{
+		{"Friendly date", "YYYY/MM/DD hh:mm:ss", true},
+		{"Bare Go numeric layout", "02/01/2006", false},
+		{"RFC1123 built from reference-time names", "Mon, 02 Jan 2006 15:04:05 MST", false},
+		{"ANSIC built from reference-time names", "Mon Jan _2 15:04:05 2006", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsFriendlyFormat(tt.format); got != tt.want {
+				t.Errorf("IsFriendlyFormat(%q) = %v, want %v", tt.format, got, tt.want)
+			}
+		})
+	}
+}
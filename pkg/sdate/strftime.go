@@ -0,0 +1,175 @@
+package sdate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatStrftime renders t using a POSIX/GNU strftime-style format string
+// (e.g. "%Y-%m-%d %H:%M:%S"). Unlike ConvertFormat, which only rewrites a
+// layout string for later use with time.Format, FormatStrftime formats the
+// time directly so that directives with no Go layout equivalent — %j
+// (day-of-year), %U/%W (week-of-year, Sunday/Monday based), %V (ISO week),
+// and %N/%3N/%6N (fractional seconds) — can be computed from t itself.
+//
+// Supported directives: %Y %y %m %d %e %H %I %M %S %p %j %U %W %V %G %A %a
+// %B %b %h %z %Z %s %N %<n>N %n %t %%. Unrecognized directives are emitted
+// literally (e.g. "%q" passes through as "%q").
+func FormatStrftime(t time.Time, format string) string {
+	var sb strings.Builder
+	runes := []rune(format)
+
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		if c != '%' || i+1 >= len(runes) {
+			sb.WriteRune(c)
+			continue
+		}
+		i++
+		next := runes[i]
+
+		// Digit-prefixed fractional seconds, e.g. %3N, %6N, %9N.
+		if next >= '0' && next <= '9' {
+			j := i
+			for j < len(runes) && runes[j] >= '0' && runes[j] <= '9' {
+				j++
+			}
+			if j < len(runes) && runes[j] == 'N' {
+				digits, _ := strconv.Atoi(string(runes[i:j]))
+				sb.WriteString(fractionalSeconds(t, digits))
+				i = j
+				continue
+			}
+			// Not actually an %N directive; pass the digits through literally.
+			sb.WriteRune('%')
+			sb.WriteString(string(runes[i:j]))
+			i = j - 1
+			continue
+		}
+
+		switch next {
+		case '%':
+			sb.WriteRune('%')
+		case 'Y':
+			sb.WriteString(t.Format("2006"))
+		case 'y':
+			sb.WriteString(t.Format("06"))
+		case 'm':
+			sb.WriteString(t.Format("01"))
+		case 'd':
+			sb.WriteString(t.Format("02"))
+		case 'e':
+			sb.WriteString(fmt.Sprintf("%2d", t.Day()))
+		case 'H':
+			sb.WriteString(t.Format("15"))
+		case 'I':
+			sb.WriteString(t.Format("03"))
+		case 'M':
+			sb.WriteString(t.Format("04"))
+		case 'S':
+			sb.WriteString(t.Format("05"))
+		case 'p':
+			sb.WriteString(t.Format("PM"))
+		case 'A':
+			sb.WriteString(t.Format("Monday"))
+		case 'a':
+			sb.WriteString(t.Format("Mon"))
+		case 'B':
+			sb.WriteString(t.Format("January"))
+		case 'b', 'h':
+			sb.WriteString(t.Format("Jan"))
+		case 'z':
+			sb.WriteString(t.Format("-0700"))
+		case 'Z':
+			sb.WriteString(t.Format("MST"))
+		case 's':
+			sb.WriteString(strconv.FormatInt(t.Unix(), 10))
+		case 'N':
+			sb.WriteString(fractionalSeconds(t, 9))
+		case 'j':
+			sb.WriteString(fmt.Sprintf("%03d", t.YearDay()))
+		case 'U':
+			sb.WriteString(fmt.Sprintf("%02d", weekOfYear(t, time.Sunday)))
+		case 'W':
+			sb.WriteString(fmt.Sprintf("%02d", weekOfYear(t, time.Monday)))
+		case 'V':
+			_, isoWeek := t.ISOWeek()
+			sb.WriteString(fmt.Sprintf("%02d", isoWeek))
+		case 'G':
+			isoYear, _ := t.ISOWeek()
+			sb.WriteString(strconv.Itoa(isoYear))
+		case 'n':
+			sb.WriteRune('\n')
+		case 't':
+			sb.WriteRune('\t')
+		default:
+			sb.WriteRune('%')
+			sb.WriteRune(next)
+		}
+	}
+
+	return sb.String()
+}
+
+// fractionalSeconds returns t's nanosecond component as a zero-padded
+// fraction truncated to the given number of digits (clamped to [0, 9]).
+func fractionalSeconds(t time.Time, digits int) string {
+	if digits <= 0 {
+		return ""
+	}
+	if digits > 9 {
+		digits = 9
+	}
+	return fmt.Sprintf("%09d", t.Nanosecond())[:digits]
+}
+
+// weekOfYear returns the week number of the year (00-53) for t, where
+// startDow is the weekday considered the first day of the week (Sunday for
+// %U, Monday for %W). Days before the year's first occurrence of startDow
+// fall in week 00.
+func weekOfYear(t time.Time, startDow time.Weekday) int {
+	yday := t.YearDay() - 1
+	diff := (int(t.Weekday()) - int(startDow) + 7) % 7
+	return (yday - diff + 7) / 7
+}
+
+// IsStrftimeFormat reports whether format looks like a strftime pattern,
+// i.e. it contains a '%' directive. Used to auto-detect the format dialect
+// when the caller hasn't explicitly chosen one.
+func IsStrftimeFormat(format string) bool {
+	return strings.Contains(format, "%")
+}
+
+// strftimeToLayoutReplacements maps the strftime directives that have a
+// direct Go layout equivalent. Directives with no such equivalent (%j, %U,
+// %W, %V, %N, ...) are left as-is, since ParseBaseWithFormat only needs a
+// layout for parsing, not the full formatting directive set.
+var strftimeToLayoutReplacements = []struct{ from, to string }{
+	{"%Y", "2006"},
+	{"%y", "06"},
+	{"%m", "01"},
+	{"%d", "02"},
+	{"%H", "15"},
+	{"%I", "03"},
+	{"%M", "04"},
+	{"%S", "05"},
+	{"%p", "PM"},
+	{"%z", "-0700"},
+	{"%Z", "MST"},
+	{"%A", "Monday"},
+	{"%a", "Mon"},
+	{"%B", "January"},
+	{"%b", "Jan"},
+	{"%%", "%"},
+}
+
+// strftimeToLayout converts a strftime pattern to a Go time layout, for the
+// directives that have a direct equivalent.
+func strftimeToLayout(format string) string {
+	for _, r := range strftimeToLayoutReplacements {
+		format = strings.ReplaceAll(format, r.from, r.to)
+	}
+	return format
+}
@@ -0,0 +1,97 @@
+package sdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatStrftime(t *testing.T) {
+	base := time.Date(2023, 10, 27, 14, 5, 9, 123456789, time.UTC)
+
+	tests := []struct {
+		name   string
+		format string
+		want   string
+	}{
+		{"basic date and time", "%Y-%m-%d %H:%M:%S", "2023-10-27 14:05:09"},
+		{"2-digit year", "%y", "23"},
+		{"12-hour and am/pm", "%I %p", "02 PM"},
+		{"weekday and month names", "%A %a %B %b", "Friday Fri October Oct"},
+		{"literal percent", "100%%", "100%"},
+		{"day of year", "%j", "300"},
+		{"unix seconds", "%s", "1698415509"},
+		{"milliseconds", "%3N", "123"},
+		{"microseconds", "%6N", "123456"},
+		{"nanoseconds", "%N", "123456789"},
+		{"unknown directive passes through", "%q", "%q"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatStrftime(base, tt.format); got != tt.want {
+				t.Errorf("FormatStrftime(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeekOfYear(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     time.Time
+		startDow time.Weekday
+		want     int
+	}{
+		{"Jan 1 2023 (Sunday), Sunday-based", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Sunday, 1},
+		{"Jan 1 2023 (Sunday), Monday-based", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Monday, 0},
+		{"Dec 31 2023 (Sunday), Sunday-based", time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), time.Sunday, 53},
+		{"Dec 31 2023 (Sunday), Monday-based", time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), time.Monday, 52},
+		{"Jan 1 2021 (Friday), Sunday-based", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), time.Sunday, 0},
+	}
+
+	t.Run("ISO week across a year boundary", func(t *testing.T) {
+		// 2021-01-01 falls in ISO week 53 of 2020.
+		date := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		if got := FormatStrftime(date, "%G-W%V"); got != "2020-W53" {
+			t.Errorf("FormatStrftime() = %q, want %q", got, "2020-W53")
+		}
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := weekOfYear(tt.date, tt.startDow); got != tt.want {
+				t.Errorf("weekOfYear() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatTimeStyles(t *testing.T) {
+	base := time.Date(2023, 10, 27, 14, 5, 9, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		format string
+		style  FormatStyle
+		want   string
+	}{
+		{"auto-detect strftime", "%Y-%m-%d", FormatStyleAuto, "2023-10-27"},
+		{"auto-detect friendly", "YYYY-MM-DD", FormatStyleAuto, "2023-10-27"},
+		{"explicit strftime", "%H:%M", FormatStyleStrftime, "14:05"},
+		{"explicit go layout", "2006-01-02T15:04:05", FormatStyleGo, "2023-10-27T14:05:09"},
+		{"explicit friendly", "YYYY/MM/DD", FormatStyleFriendly, "2023/10/27"},
+		{"explicit friendly with ISO week", "YYYY-IW", FormatStyleFriendly, "2023-43"},
+		{"unix keyword", "unix", FormatStyleAuto, "1698415509"},
+		{"epoch keyword case-insensitive", "Epoch", FormatStyleAuto, "1698415509"},
+		{"auto-detect Go layout built from reference-time names (RFC1123)", time.RFC1123, FormatStyleAuto, "Fri, 27 Oct 2023 14:05:09 UTC"},
+		{"auto-detect Go layout built from reference-time names (ANSIC)", time.ANSIC, FormatStyleAuto, "Fri Oct 27 14:05:09 2023"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatTime(base, tt.format, tt.style); got != tt.want {
+				t.Errorf("FormatTime(%q, %q) = %q, want %q", tt.format, tt.style, got, tt.want)
+			}
+		})
+	}
+}